@@ -0,0 +1,43 @@
+package copy_struct
+
+import (
+	"github.com/ssdfcc/common/validate"
+)
+
+// CopyOption 用于配置 CopyAndValidate 的校验行为。这组选项只作用于
+// CopyAndValidate 自身的校验步骤，不会传入 CopyStruct：CopyStruct 的
+// 自定义转换器（RegisterConverter）和重命名/跳过规则（copy:"..." 标签）
+// 已经分别是全局注册表和结构体标签，调用方按需在这两处配置即可，无需
+// 再额外引入一份按调用传递的选项。
+type CopyOption func(*copyOptions)
+
+// copyOptions 聚合 CopyAndValidate 的可选配置
+type copyOptions struct {
+	validator func(any) error
+}
+
+// WithValidator 指定一个自定义校验函数，替代默认的 validate 包全局校验器。
+// 适用于需要按请求范围构造独立校验器的场景。
+func WithValidator(fn func(any) error) CopyOption {
+	return func(o *copyOptions) {
+		o.validator = fn
+	}
+}
+
+// CopyAndValidate 先执行 CopyStruct 将 src 映射到 dest，再对 dest 执行校验，
+// 合并"入站请求映射 + 校验"这一常见的两步用法。默认使用 validate 包的全局
+// 校验器并返回翻译后的中文错误信息，可通过 WithValidator 注入自定义校验函数。
+func CopyAndValidate(src, dest any, opts ...CopyOption) error {
+	if err := CopyStruct(src, dest); err != nil {
+		return err
+	}
+
+	o := &copyOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.validator != nil {
+		return o.validator(dest)
+	}
+	return validate.Validate(dest)
+}
@@ -0,0 +1,45 @@
+package copy_struct
+
+import (
+	"errors"
+	"testing"
+)
+
+type validateSrc struct {
+	Name string
+}
+
+type validateDest struct {
+	Name string `validate:"required" label:"姓名"`
+}
+
+func TestCopyAndValidate_CopiesThenValidates(t *testing.T) {
+	src := validateSrc{Name: "Alice"}
+	var dest validateDest
+	if err := CopyAndValidate(&src, &dest); err != nil {
+		t.Fatalf("CopyAndValidate returned error: %v", err)
+	}
+	if dest.Name != "Alice" {
+		t.Fatalf("expected Name to be copied, got %q", dest.Name)
+	}
+}
+
+func TestCopyAndValidate_ReturnsValidationError(t *testing.T) {
+	src := validateSrc{}
+	var dest validateDest
+	if err := CopyAndValidate(&src, &dest); err == nil {
+		t.Fatal("expected validation error for missing required field, got nil")
+	}
+}
+
+func TestCopyAndValidate_WithValidatorOverridesDefault(t *testing.T) {
+	wantErr := errors.New("custom validator invoked")
+	src := validateSrc{Name: "Alice"}
+	var dest validateDest
+	err := CopyAndValidate(&src, &dest, WithValidator(func(v any) error {
+		return wantErr
+	}))
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("expected custom validator error, got %v", err)
+	}
+}
@@ -14,17 +14,23 @@ import (
 
 // typeCache 用于缓存类型映射，避免重复计算
 var (
-	typeCache sync.Map
-	bufPool   = sync.Pool{New: func() any { return new(bytes.Buffer) }}
+	typeCache         sync.Map
+	bufPool           = sync.Pool{New: func() any { return new(bytes.Buffer) }}
+	converterRegistry sync.Map // typePair -> func(src, dest unsafe.Pointer) error
 )
 
 // fieldMapping 定义了源和目标字段之间的映射关系
 type fieldMapping struct {
-	srcOffset  uintptr
-	destOffset uintptr
-	timeLayout string
-	isNested   bool
-	converter  func(src unsafe.Pointer, dest unsafe.Pointer) error
+	srcOffset     uintptr
+	destOffset    uintptr
+	srcType       reflect.Type
+	fieldName     string
+	timeLayout    string
+	isNested      bool
+	omitempty     bool
+	required      bool
+	converter     func(src unsafe.Pointer, dest unsafe.Pointer) error
+	elemConverter func(src unsafe.Pointer, dest unsafe.Pointer) error
 }
 
 // typePair 用于在缓存中存储源和目标类型的组合
@@ -33,6 +39,58 @@ type typePair struct {
 	dest reflect.Type
 }
 
+// typeMappingEntry 是 typeCache 中存储的值，除字段映射外还记录了构建过程中
+// 查询过自定义转换器注册表的类型组合（便于 RegisterConverter 精准失效缓存），
+// 以及目标结构体上 copy:"autoid=..."/copy:"autotime=..." 驱动的自动填充规则
+type typeMappingEntry struct {
+	mappings  []fieldMapping
+	usedPairs []typePair
+	autoFills []autoFillMapping
+}
+
+// RegisterConverter 注册一个 (src, dest) 类型组合的自定义转换函数，
+// 使用方可以自行处理 analyzeFieldMapping 无法识别的类型，例如
+// decimal.Decimal -> string、uuid.UUID -> string、sql.NullString -> *string、
+// time.Duration -> int64 等。analyzeFieldMapping 在识别字段类型时会优先
+// 查询该注册表，命中时跳过内置的时间/嵌套结构体/基础类型转换逻辑。
+func RegisterConverter(src, dest reflect.Type, fn func(src, dest unsafe.Pointer) error) {
+	key := typePair{src: src, dest: dest}
+	converterRegistry.Store(key, fn)
+	invalidateTypeCache(key)
+}
+
+// RegisterConverterFunc 是 RegisterConverter 的 reflect.Value 版本，
+// 供不希望直接操作 unsafe.Pointer 的调用方使用
+func RegisterConverterFunc(src, dest reflect.Type, fn func(src, dest reflect.Value) error) {
+	RegisterConverter(src, dest, func(srcPtr, destPtr unsafe.Pointer) error {
+		return fn(reflect.NewAt(src, srcPtr).Elem(), reflect.NewAt(dest, destPtr).Elem())
+	})
+}
+
+// lookupRegisteredConverter 查询 (src, dest) 是否存在自定义转换器
+func lookupRegisteredConverter(src, dest reflect.Type) (func(unsafe.Pointer, unsafe.Pointer) error, bool) {
+	v, ok := converterRegistry.Load(typePair{src: src, dest: dest})
+	if !ok {
+		return nil, false
+	}
+	return v.(func(unsafe.Pointer, unsafe.Pointer) error), true
+}
+
+// invalidateTypeCache 清除所有直接查询过指定类型组合的已缓存映射，
+// 使下一次 CopyStruct 调用重新走 analyzeFieldMapping 以应用新注册的转换器
+func invalidateTypeCache(pair typePair) {
+	typeCache.Range(func(key, value any) bool {
+		entry := value.(typeMappingEntry)
+		for _, p := range entry.usedPairs {
+			if p == pair {
+				typeCache.Delete(key)
+				break
+			}
+		}
+		return true
+	})
+}
+
 // CopyStruct 是结构体复制的主入口函数
 // 它接受源和目标结构体作为参数，并执行深拷贝操作
 func CopyStruct(src interface{}, dest interface{}) (err error) {
@@ -70,60 +128,239 @@ func copyStructRecursive(srcVal, destVal reflect.Value) error {
 	}
 
 	cached, ok := typeCache.Load(cacheKey)
-	var mappings []fieldMapping
+	var entry typeMappingEntry
 
 	if !ok {
-		mappings = createTypeMapping(srcVal, destVal)
-		typeCache.Store(cacheKey, mappings)
+		entry = createTypeMapping(srcVal, destVal)
+		typeCache.Store(cacheKey, entry)
 	} else {
-		mappings = cached.([]fieldMapping)
+		entry = cached.(typeMappingEntry)
 	}
 
-	return applyFieldMappings(srcVal, destVal, mappings)
+	return applyFieldMappings(srcVal, destVal, entry.mappings, entry.autoFills)
+}
+
+// copyTagOptions 保存 copy:"..." 标签解析出的字段级控制选项
+type copyTagOptions struct {
+	name      string
+	omitempty bool
+	required  bool
+	skip      bool
+	autoID    string
+	autoTime  string
 }
 
-// createTypeMapping 创建源和目标结构体的字段映射
-func createTypeMapping(srcVal, destVal reflect.Value) []fieldMapping {
+// parseCopyTag 解析 copy:"..." 标签，支持 name=DestFieldName、omitempty、required、
+// autoid=snowflake、autotime=now/nowstring 和 -
+func parseCopyTag(tag string) copyTagOptions {
+	var opts copyTagOptions
+	if tag == "" {
+		return opts
+	}
+	if tag == "-" {
+		opts.skip = true
+		return opts
+	}
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		switch {
+		case part == "omitempty":
+			opts.omitempty = true
+		case part == "required":
+			opts.required = true
+		case strings.HasPrefix(part, "name="):
+			opts.name = strings.TrimPrefix(part, "name=")
+		case strings.HasPrefix(part, "autoid="):
+			opts.autoID = strings.TrimPrefix(part, "autoid=")
+		case strings.HasPrefix(part, "autotime="):
+			opts.autoTime = strings.TrimPrefix(part, "autotime=")
+		}
+	}
+	return opts
+}
+
+// defaultTimeLayout 是 to:"timeString" 标签使用的默认时间格式
+const defaultTimeLayout = "2006-01-02 15:04:05"
+
+// parseTimeLayoutTag 解析 to:"timeFormat:..."/to:"timeString" 标签，返回对应的时间格式；
+// 标签不存在或不匹配时返回空字符串
+func parseTimeLayoutTag(tag string) string {
+	switch {
+	case strings.HasPrefix(tag, "timeFormat:"):
+		return strings.SplitN(tag, ":", 2)[1]
+	case tag == "timeString":
+		return defaultTimeLayout
+	default:
+		return ""
+	}
+}
+
+// buildDestFieldIndex 构建目标结构体的字段索引：字段名 -> StructField，
+// 若字段带有 copy:"name=..." 标签则额外以该别名建立索引，
+// 使源字段可以通过 copy:"name=..." 匹配到重命名后的目标字段
+func buildDestFieldIndex(destType reflect.Type) map[string]reflect.StructField {
+	index := make(map[string]reflect.StructField, destType.NumField())
+	for i := 0; i < destType.NumField(); i++ {
+		f := destType.Field(i)
+		index[f.Name] = f
+		if opts := parseCopyTag(f.Tag.Get("copy")); opts.name != "" {
+			index[opts.name] = f
+		}
+	}
+	return index
+}
+
+// createTypeMapping 创建源和目标结构体的字段映射，支持 copy:"..." 标签做字段
+// 重命名（name=...）、跳过（-）、按零值忽略（omitempty）以及必填校验（required）
+func createTypeMapping(srcVal, destVal reflect.Value) typeMappingEntry {
 	srcType := srcVal.Type()
 	destType := destVal.Type()
 	mappings := make([]fieldMapping, 0, srcType.NumField())
+	var usedPairs []typePair
+
+	destIndex := buildDestFieldIndex(destType)
 
 	for i := 0; i < srcType.NumField(); i++ {
 		srcField := srcType.Field(i)
-		if destField, ok := destType.FieldByName(srcField.Name); ok {
-			if !destVal.FieldByName(srcField.Name).CanSet() {
-				continue
+		srcOpts := parseCopyTag(srcField.Tag.Get("copy"))
+		if srcOpts.skip {
+			continue
+		}
+
+		destName := srcField.Name
+		if srcOpts.name != "" {
+			destName = srcOpts.name
+		}
+
+		destField, ok := destIndex[destName]
+		if !ok || parseCopyTag(destField.Tag.Get("copy")).skip {
+			continue
+		}
+		if !destVal.FieldByName(destField.Name).CanSet() {
+			continue
+		}
+
+		mapping, pairs := analyzeFieldMapping(srcField, destField)
+		mapping.omitempty = srcOpts.omitempty
+		mapping.required = srcOpts.required
+		mappings = append(mappings, mapping)
+		usedPairs = append(usedPairs, pairs...)
+	}
+	return typeMappingEntry{mappings: mappings, usedPairs: usedPairs, autoFills: buildAutoFillMappings(destType)}
+}
+
+// autoFillKind 标识一个目标字段应当如何自动填充
+type autoFillKind int
+
+const (
+	autoFillIDSnowflake autoFillKind = iota + 1
+	autoFillTimeNow
+	autoFillTimeNowString
+)
+
+// autoFillMapping 描述一个由 copy:"autoid=..."/copy:"autotime=..." 标签驱动的
+// 目标字段自动填充规则；仅在正常拷贝后该字段仍为零值时生效
+type autoFillMapping struct {
+	destOffset uintptr
+	destType   reflect.Type
+	kind       autoFillKind
+	timeLayout string
+}
+
+// idGenerator 是 SetIDGenerator 注入的 ID 生成函数（如 Snowflake），
+// 默认为空，此时 copy:"autoid=snowflake" 不生效，模块本身不引入硬依赖
+var (
+	idGeneratorMu sync.RWMutex
+	idGenerator   func() int64
+)
+
+// SetIDGenerator 注入一个 ID 生成函数，供 copy:"autoid=snowflake" 使用，
+// 调用方可以接入自己的 Snowflake/UUID 等实现
+func SetIDGenerator(fn func() int64) {
+	idGeneratorMu.Lock()
+	defer idGeneratorMu.Unlock()
+	idGenerator = fn
+}
+
+// currentIDGenerator 返回当前注入的 ID 生成函数
+func currentIDGenerator() func() int64 {
+	idGeneratorMu.RLock()
+	defer idGeneratorMu.RUnlock()
+	return idGenerator
+}
+
+// buildAutoFillMappings 扫描目标结构体字段上的 copy:"autoid=..."/copy:"autotime=..."
+// 标签，生成自动填充规则。autotime=nowstring 复用该字段自身的 to:"timeFormat:..."/
+// to:"timeString" 标签解析时间格式，缺省时回退到 defaultTimeLayout。
+func buildAutoFillMappings(destType reflect.Type) []autoFillMapping {
+	var fills []autoFillMapping
+	for i := 0; i < destType.NumField(); i++ {
+		field := destType.Field(i)
+		opts := parseCopyTag(field.Tag.Get("copy"))
+
+		switch {
+		case opts.autoID == "snowflake" && field.Type.Kind() == reflect.Int64:
+			fills = append(fills, autoFillMapping{destOffset: field.Offset, destType: field.Type, kind: autoFillIDSnowflake})
+
+		case opts.autoTime == "now" && isTimeOrTimePtr(field.Type):
+			fills = append(fills, autoFillMapping{destOffset: field.Offset, destType: field.Type, kind: autoFillTimeNow})
+
+		case opts.autoTime == "nowstring" && field.Type.Kind() == reflect.String:
+			layout := parseTimeLayoutTag(field.Tag.Get("to"))
+			if layout == "" {
+				layout = defaultTimeLayout
 			}
-			mappings = append(mappings, analyzeFieldMapping(srcField, destField))
+			fills = append(fills, autoFillMapping{destOffset: field.Offset, destType: field.Type, kind: autoFillTimeNowString, timeLayout: layout})
 		}
 	}
-	return mappings
+	return fills
+}
+
+// isTimeOrTimePtr 判断类型是否为 time.Time 或 *time.Time
+func isTimeOrTimePtr(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t == reflect.TypeOf(time.Time{})
 }
 
-// analyzeFieldMapping 分析并返回两个结构体字段之间的映射关系
-func analyzeFieldMapping(srcField, destField reflect.StructField) fieldMapping {
+// analyzeFieldMapping 分析并返回两个结构体字段之间的映射关系，以及过程中
+// 查询过自定义转换器注册表的类型组合（供 RegisterConverter 失效缓存使用）
+func analyzeFieldMapping(srcField, destField reflect.StructField) (fieldMapping, []typePair) {
 	mapping := fieldMapping{
 		srcOffset:  srcField.Offset,
 		destOffset: destField.Offset,
+		srcType:    srcField.Type,
+		fieldName:  srcField.Name,
+	}
+
+	// 无论是否命中都记录本字段查询过的类型组合，使之后注册的转换器也能失效该缓存
+	queried := []typePair{{src: srcField.Type, dest: destField.Type}}
+
+	// 自定义转换器优先级最高，用户注册的转换逻辑始终覆盖内置规则
+	if fn, ok := lookupRegisteredConverter(srcField.Type, destField.Type); ok {
+		mapping.converter = fn
+		return mapping, queried
 	}
 
 	// 解析时间格式标签
-	if tag := srcField.Tag.Get("to"); tag != "" {
-		if strings.HasPrefix(tag, "timeFormat:") {
-			mapping.timeLayout = strings.SplitN(tag, ":", 2)[1]
-		} else if tag == "timeString" {
-			mapping.timeLayout = "2006-01-02 15:04:05"
-		}
+	mapping.timeLayout = parseTimeLayoutTag(srcField.Tag.Get("to"))
+
+	// 验证时间字段类型（正向 time.Time -> string，或反向 string -> time.Time）
+	if mapping.timeLayout != "" && !isTimeStringConvertible(srcField.Type, destField.Type) &&
+		!isStringTimeConvertible(srcField.Type, destField.Type) {
+		mapping.timeLayout = ""
 	}
 
-	// 验证时间字段类型
-	if mapping.timeLayout != "" {
-		srcType := srcField.Type
-		if srcType.Kind() == reflect.Ptr {
-			srcType = srcType.Elem()
-		}
-		if srcType != reflect.TypeOf(time.Time{}) || destField.Type.Kind() != reflect.String {
-			mapping.timeLayout = ""
+	// 集合类型（slice/array/map）递归复制每个元素，复用与顶层字段相同的转换规则；
+	// 上面的校验针对字段本身的类型，会清空集合字段的时间格式，因此这里重新解析标签，
+	// 由元素类型决定是否使用
+	switch srcField.Type.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if conv, elemConv, pairs := createCollectionConverter(srcField.Type, destField.Type, parseTimeLayoutTag(srcField.Tag.Get("to")), srcField.Name); conv != nil {
+			mapping.converter = conv
+			mapping.elemConverter = elemConv
+			return mapping, append(queried, pairs...)
 		}
 	}
 
@@ -134,26 +371,80 @@ func analyzeFieldMapping(srcField, destField reflect.StructField) fieldMapping {
 
 	// 生成高效转换器
 	mapping.converter = createConverter(srcField.Type, destField.Type, mapping)
-	// 强制检查基础类型转换
-	if isBasicToStringConvertible(srcField.Type, destField.Type) {
+	// 强制检查基础类型转换，string -> 基础类型/time.Time 的反向转换优先于其它规则
+	switch {
+	case isStringToBasicConvertible(srcField.Type, destField.Type, mapping.timeLayout):
+		mapping.converter = createStringToBasicConverter(destField.Type, mapping.timeLayout, srcField.Name)
+	case isBasicToStringConvertible(srcField.Type, destField.Type):
 		mapping.converter = createBasicToStringConverter(srcField.Type)
-	} else if srcField.Type.ConvertibleTo(destField.Type) {
+	case !mapping.isNested && srcField.Type.ConvertibleTo(destField.Type):
 		mapping.converter = createBasicConverter(srcField.Type, destField.Type)
 	}
-	return mapping
+	return mapping, queried
 }
 
-// applyFieldMappings 应用字段映射，将源结构体的字段值复制到目标结构体
-func applyFieldMappings(srcVal, destVal reflect.Value, mappings []fieldMapping) error {
+// applyFieldMappings 应用字段映射，将源结构体的字段值复制到目标结构体，
+// 并按 copy:"omitempty"/copy:"required" 标签执行零值忽略或必填校验；随后对
+// autoFills 中描述的目标字段执行第二轮自动填充，仅在字段仍为零值时生效，
+// 确保已由正常映射写入的值始终优先于自动填充
+func applyFieldMappings(srcVal, destVal reflect.Value, mappings []fieldMapping, autoFills []autoFillMapping) error {
 	srcPtr := unsafe.Pointer(srcVal.UnsafeAddr())
 	destPtr := unsafe.Pointer(destVal.UnsafeAddr())
 
 	for _, m := range mappings {
-		if m.converter != nil {
-			if err := m.converter(unsafe.Add(srcPtr, m.srcOffset), unsafe.Add(destPtr, m.destOffset)); err != nil {
-				return err
+		if m.converter == nil {
+			continue
+		}
+
+		srcFieldPtr := unsafe.Add(srcPtr, m.srcOffset)
+		if m.omitempty || m.required {
+			if reflect.NewAt(m.srcType, srcFieldPtr).Elem().IsZero() {
+				if m.required {
+					return fmt.Errorf("copy_struct: field %q is required but has zero value", m.fieldName)
+				}
+				continue
 			}
 		}
+
+		if err := m.converter(srcFieldPtr, unsafe.Add(destPtr, m.destOffset)); err != nil {
+			return err
+		}
+	}
+
+	for _, af := range autoFills {
+		if err := applyAutoFill(unsafe.Add(destPtr, af.destOffset), af); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyAutoFill 对单个目标字段执行自动填充，仅当该字段当前仍为零值时生效，
+// 避免覆盖已经由 src -> dest 正常映射写入的值
+func applyAutoFill(destFieldPtr unsafe.Pointer, af autoFillMapping) error {
+	destFieldVal := reflect.NewAt(af.destType, destFieldPtr).Elem()
+	if !destFieldVal.IsZero() {
+		return nil
+	}
+
+	switch af.kind {
+	case autoFillIDSnowflake:
+		gen := currentIDGenerator()
+		if gen == nil {
+			return nil
+		}
+		*(*int64)(destFieldPtr) = gen()
+
+	case autoFillTimeNow:
+		now := time.Now()
+		if af.destType.Kind() == reflect.Ptr {
+			*(*unsafe.Pointer)(destFieldPtr) = unsafe.Pointer(&now)
+		} else {
+			*(*time.Time)(destFieldPtr) = now
+		}
+
+	case autoFillTimeNowString:
+		*(*string)(destFieldPtr) = time.Now().Format(af.timeLayout)
 	}
 	return nil
 }
@@ -310,9 +601,263 @@ func createBasicToStringConverter(srcType reflect.Type) func(unsafe.Pointer, uns
 	}
 }
 
+// isStringToBasicConvertible 检查是否可以将 string 源字段反向填充到
+// numeric/bool/time.Time 目标字段。time.Time 目标要求调用方已经解析出
+// 有效的 timeLayout（来自 to:"timeFormat:..." 或 to:"timeString" 标签）。
+func isStringToBasicConvertible(srcType, destType reflect.Type, timeLayout string) bool {
+	if srcType.Kind() != reflect.String {
+		return false
+	}
+	if destType == reflect.TypeOf(time.Time{}) {
+		return timeLayout != ""
+	}
+	switch destType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.Bool:
+		return true
+	default:
+		return false
+	}
+}
+
+// createStringToBasicConverter 创建 string 源字段到 numeric/bool/time.Time 目标字段的
+// 转换函数。空字符串保留目标字段的零值且不报错；解析失败返回携带源字段名的错误，
+// 便于调用方定位问题字段。
+func createStringToBasicConverter(destType reflect.Type, timeLayout, fieldName string) func(unsafe.Pointer, unsafe.Pointer) error {
+	return func(src, dest unsafe.Pointer) error {
+		s := *(*string)(src)
+		if s == "" {
+			return nil
+		}
+
+		var err error
+		switch {
+		case destType == reflect.TypeOf(time.Time{}):
+			var t time.Time
+			if t, err = time.Parse(timeLayout, s); err == nil {
+				*(*time.Time)(dest) = t
+			}
+		case destType.Kind() == reflect.Bool:
+			var v bool
+			if v, err = strconv.ParseBool(s); err == nil {
+				*(*bool)(dest) = v
+			}
+		case destType.Kind() == reflect.Float32 || destType.Kind() == reflect.Float64:
+			var v float64
+			if v, err = strconv.ParseFloat(s, destType.Bits()); err == nil {
+				setFloatValue(dest, destType.Kind(), v)
+			}
+		case isUintKind(destType.Kind()):
+			var v uint64
+			if v, err = strconv.ParseUint(s, 10, destType.Bits()); err == nil {
+				setUintValue(dest, destType.Kind(), v)
+			}
+		default:
+			var v int64
+			if v, err = strconv.ParseInt(s, 10, destType.Bits()); err == nil {
+				setIntValue(dest, destType.Kind(), v)
+			}
+		}
+
+		if err != nil {
+			return fmt.Errorf("copy_struct: parse field %q: %w", fieldName, err)
+		}
+		return nil
+	}
+}
+
+// isUintKind 判断 Kind 是否属于无符号整型家族
+func isUintKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+// setIntValue 按目标字段的实际位宽写入有符号整数
+func setIntValue(dest unsafe.Pointer, kind reflect.Kind, v int64) {
+	switch kind {
+	case reflect.Int:
+		*(*int)(dest) = int(v)
+	case reflect.Int8:
+		*(*int8)(dest) = int8(v)
+	case reflect.Int16:
+		*(*int16)(dest) = int16(v)
+	case reflect.Int32:
+		*(*int32)(dest) = int32(v)
+	case reflect.Int64:
+		*(*int64)(dest) = v
+	}
+}
+
+// setUintValue 按目标字段的实际位宽写入无符号整数
+func setUintValue(dest unsafe.Pointer, kind reflect.Kind, v uint64) {
+	switch kind {
+	case reflect.Uint:
+		*(*uint)(dest) = uint(v)
+	case reflect.Uint8:
+		*(*uint8)(dest) = uint8(v)
+	case reflect.Uint16:
+		*(*uint16)(dest) = uint16(v)
+	case reflect.Uint32:
+		*(*uint32)(dest) = uint32(v)
+	case reflect.Uint64:
+		*(*uint64)(dest) = v
+	}
+}
+
+// setFloatValue 按目标字段的实际位宽写入浮点数
+func setFloatValue(dest unsafe.Pointer, kind reflect.Kind, v float64) {
+	switch kind {
+	case reflect.Float32:
+		*(*float32)(dest) = float32(v)
+	case reflect.Float64:
+		*(*float64)(dest) = v
+	}
+}
+
 // isNestedType 检查类型是否为嵌套结构体
 func isNestedType(src, dest reflect.Type) bool {
 	isSrcStruct := src.Kind() == reflect.Struct || (src.Kind() == reflect.Ptr && src.Elem().Kind() == reflect.Struct)
 	isDestStruct := dest.Kind() == reflect.Struct || (dest.Kind() == reflect.Ptr && dest.Elem().Kind() == reflect.Struct)
 	return isSrcStruct && isDestStruct
 }
+
+// isTimeStringConvertible 判断字段是否满足 time.Time（或其指针）到 string 的格式化转换条件
+func isTimeStringConvertible(srcType, destType reflect.Type) bool {
+	if srcType.Kind() == reflect.Ptr {
+		srcType = srcType.Elem()
+	}
+	return srcType == reflect.TypeOf(time.Time{}) && destType.Kind() == reflect.String
+}
+
+// isStringTimeConvertible 判断字段是否满足 string 到 time.Time 的反向解析条件
+func isStringTimeConvertible(srcType, destType reflect.Type) bool {
+	return srcType.Kind() == reflect.String && destType == reflect.TypeOf(time.Time{})
+}
+
+// createCollectionConverter 为 slice/array/map 字段创建转换函数，返回字段级转换器、
+// 可复用的元素级转换器（elemConverter），以及过程中查询过自定义转换器注册表的类型组合。
+// 元素转换遵循与顶层字段一致的规则：自定义转换器优先、数值类型通过 ConvertibleTo
+// 宽化、基础类型转字符串、嵌套结构体递归以及 timeFormat。
+func createCollectionConverter(srcType, destType reflect.Type, timeLayout, fieldName string) (func(unsafe.Pointer, unsafe.Pointer) error, func(unsafe.Pointer, unsafe.Pointer) error, []typePair) {
+	switch srcType.Kind() {
+	case reflect.Slice, reflect.Array:
+		if destType.Kind() != reflect.Slice && destType.Kind() != reflect.Array {
+			return nil, nil, nil
+		}
+		elemConv, _ := analyzeElementConverter(srcType.Elem(), destType.Elem(), timeLayout, fieldName+"[]")
+		if elemConv == nil {
+			return nil, nil, nil
+		}
+		return createSliceConverter(srcType, destType, elemConv), elemConv, collectionUsedPairs(srcType.Elem(), destType.Elem())
+
+	case reflect.Map:
+		if destType.Kind() != reflect.Map {
+			return nil, nil, nil
+		}
+		if srcType.Key() != destType.Key() && !srcType.Key().ConvertibleTo(destType.Key()) {
+			return nil, nil, nil
+		}
+		elemConv, _ := analyzeElementConverter(srcType.Elem(), destType.Elem(), timeLayout, fieldName+"{}")
+		if elemConv == nil {
+			return nil, nil, nil
+		}
+		return createMapConverter(srcType, destType, elemConv), elemConv, collectionUsedPairs(srcType.Elem(), destType.Elem())
+
+	default:
+		return nil, nil, nil
+	}
+}
+
+// collectionUsedPairs 返回元素转换查询过的类型组合。analyzeElementConverter 总会
+// 查询注册表，因此无论是否命中都需要记录
+func collectionUsedPairs(srcElem, destElem reflect.Type) []typePair {
+	return []typePair{{src: srcElem, dest: destElem}}
+}
+
+// analyzeElementConverter 为集合元素选择转换器，元素类型为结构体时复用缓存的
+// []fieldMapping（通过 copyStructRecursive 的 typeCache）。返回值的第二个结果
+// 表示该转换是否命中了自定义转换器注册表。
+func analyzeElementConverter(srcElemType, destElemType reflect.Type, timeLayout, fieldName string) (func(unsafe.Pointer, unsafe.Pointer) error, bool) {
+	if fn, ok := lookupRegisteredConverter(srcElemType, destElemType); ok {
+		return fn, true
+	}
+	switch {
+	case timeLayout != "" && isTimeStringConvertible(srcElemType, destElemType):
+		return createTimeConverter(srcElemType, timeLayout), false
+	case isStringToBasicConvertible(srcElemType, destElemType, timeLayout):
+		return createStringToBasicConverter(destElemType, timeLayout, fieldName), false
+	case isNestedType(srcElemType, destElemType):
+		return createNestedConverter(srcElemType, destElemType), false
+	case isBasicToStringConvertible(srcElemType, destElemType):
+		return createBasicToStringConverter(srcElemType), false
+	case srcElemType.ConvertibleTo(destElemType):
+		return createBasicConverter(srcElemType, destElemType), false
+	default:
+		return nil, false
+	}
+}
+
+// createSliceConverter 创建 slice/array 字段的转换函数，目标为 slice 时按源长度预分配
+func createSliceConverter(srcType, destType reflect.Type, elemConv func(unsafe.Pointer, unsafe.Pointer) error) func(unsafe.Pointer, unsafe.Pointer) error {
+	return func(src, dest unsafe.Pointer) error {
+		srcVal := reflect.NewAt(srcType, src).Elem()
+		if srcType.Kind() == reflect.Slice && srcVal.IsNil() {
+			return nil
+		}
+
+		length := srcVal.Len()
+		destVal := reflect.NewAt(destType, dest).Elem()
+		if destType.Kind() == reflect.Slice {
+			destVal.Set(reflect.MakeSlice(destType, length, length))
+		} else if length > destVal.Len() {
+			length = destVal.Len()
+		}
+
+		for i := 0; i < length; i++ {
+			srcElemVal := srcVal.Index(i)
+			destElemVal := destVal.Index(i)
+			if err := elemConv(unsafe.Pointer(srcElemVal.UnsafeAddr()), unsafe.Pointer(destElemVal.UnsafeAddr())); err != nil {
+				return fmt.Errorf("copy element %d: %w", i, err)
+			}
+		}
+		return nil
+	}
+}
+
+// createMapConverter 创建 map 字段的转换函数，key 类型相同或可转换，value 按元素规则递归复制
+func createMapConverter(srcType, destType reflect.Type, elemConv func(unsafe.Pointer, unsafe.Pointer) error) func(unsafe.Pointer, unsafe.Pointer) error {
+	sameKey := srcType.Key() == destType.Key()
+
+	return func(src, dest unsafe.Pointer) error {
+		srcVal := reflect.NewAt(srcType, src).Elem()
+		if srcVal.IsNil() {
+			return nil
+		}
+
+		destMap := reflect.MakeMapWithSize(destType, srcVal.Len())
+		iter := srcVal.MapRange()
+		for iter.Next() {
+			key := iter.Key()
+			if !sameKey {
+				key = key.Convert(destType.Key())
+			}
+
+			srcElemVal := reflect.New(srcType.Elem()).Elem()
+			srcElemVal.Set(iter.Value())
+			destElemVal := reflect.New(destType.Elem()).Elem()
+			if err := elemConv(unsafe.Pointer(srcElemVal.UnsafeAddr()), unsafe.Pointer(destElemVal.UnsafeAddr())); err != nil {
+				return fmt.Errorf("copy map value for key %v: %w", key.Interface(), err)
+			}
+			destMap.SetMapIndex(key, destElemVal)
+		}
+
+		destVal := reflect.NewAt(destType, dest).Elem()
+		destVal.Set(destMap)
+		return nil
+	}
+}
@@ -0,0 +1,72 @@
+package copy_struct
+
+import (
+	"testing"
+	"time"
+)
+
+type autoFillSrc struct {
+	Name string
+}
+
+type autoFillDest struct {
+	Name      string
+	ID        int64     `copy:"autoid=snowflake"`
+	CreatedAt time.Time `copy:"autotime=now"`
+	UpdatedAt string    `copy:"autotime=nowstring" to:"timeFormat:2006-01-02"`
+}
+
+func TestCopyStruct_AutoFillPopulatesZeroFields(t *testing.T) {
+	SetIDGenerator(func() int64 { return 42 })
+	defer SetIDGenerator(nil)
+
+	src := autoFillSrc{Name: "a"}
+	var dest autoFillDest
+	if err := CopyStruct(&src, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+	if dest.ID != 42 {
+		t.Fatalf("expected ID 42 from ID generator, got %d", dest.ID)
+	}
+	if dest.CreatedAt.IsZero() {
+		t.Fatal("expected CreatedAt to be auto-filled with current time")
+	}
+	if dest.UpdatedAt == "" {
+		t.Fatal("expected UpdatedAt to be auto-filled with formatted current time")
+	}
+	if _, err := time.Parse("2006-01-02", dest.UpdatedAt); err != nil {
+		t.Fatalf("expected UpdatedAt to match configured layout, got %q: %v", dest.UpdatedAt, err)
+	}
+}
+
+func TestCopyStruct_AutoFillDoesNotOverwriteExplicitValue(t *testing.T) {
+	SetIDGenerator(func() int64 { return 42 })
+	defer SetIDGenerator(nil)
+
+	type srcWithID struct {
+		Name string
+		ID   int64
+	}
+
+	src := srcWithID{Name: "a", ID: 99}
+	var dest autoFillDest
+	if err := CopyStruct(&src, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+	if dest.ID != 99 {
+		t.Fatalf("expected explicit ID 99 to win over autofill, got %d", dest.ID)
+	}
+}
+
+func TestCopyStruct_AutoFillNoGeneratorLeavesZero(t *testing.T) {
+	SetIDGenerator(nil)
+
+	src := autoFillSrc{Name: "a"}
+	var dest autoFillDest
+	if err := CopyStruct(&src, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+	if dest.ID != 0 {
+		t.Fatalf("expected ID to stay zero without a registered generator, got %d", dest.ID)
+	}
+}
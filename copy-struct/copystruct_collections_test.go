@@ -0,0 +1,111 @@
+package copy_struct
+
+import "testing"
+
+type collElem struct {
+	Name string
+}
+
+type collSrc struct {
+	Structs    []collElem
+	StructPtrs []*collElem
+	Ints       []int
+	Tags       map[string]collElem
+	NilSlice   []string
+	NilMap     map[string]string
+}
+
+type collDest struct {
+	Structs    []collElem
+	StructPtrs []*collElem
+	Ints       []int64
+	Tags       map[string]collElem
+	NilSlice   []string
+	NilMap     map[string]string
+}
+
+func TestCopyStruct_SliceOfStructs(t *testing.T) {
+	src := collSrc{Structs: []collElem{{Name: "a"}, {Name: "b"}}}
+	var dest collDest
+	if err := CopyStruct(&src, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+	if len(dest.Structs) != 2 || dest.Structs[0].Name != "a" || dest.Structs[1].Name != "b" {
+		t.Fatalf("unexpected dest.Structs: %+v", dest.Structs)
+	}
+}
+
+func TestCopyStruct_SliceOfStructPointers(t *testing.T) {
+	src := collSrc{StructPtrs: []*collElem{{Name: "a"}, {Name: "b"}}}
+	var dest collDest
+	if err := CopyStruct(&src, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+	if len(dest.StructPtrs) != 2 || dest.StructPtrs[0].Name != "a" {
+		t.Fatalf("unexpected dest.StructPtrs: %+v", dest.StructPtrs)
+	}
+	if dest.StructPtrs[0] == src.StructPtrs[0] {
+		t.Fatal("dest element should not alias src element")
+	}
+}
+
+func TestCopyStruct_MapOfStructs(t *testing.T) {
+	src := collSrc{Tags: map[string]collElem{"k": {Name: "a"}}}
+	var dest collDest
+	if err := CopyStruct(&src, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+	if dest.Tags["k"].Name != "a" {
+		t.Fatalf("unexpected dest.Tags: %+v", dest.Tags)
+	}
+}
+
+func TestCopyStruct_WideningSlice(t *testing.T) {
+	src := collSrc{Ints: []int{1, 2, 3}}
+	var dest collDest
+	if err := CopyStruct(&src, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+	if len(dest.Ints) != 3 || dest.Ints[2] != 3 {
+		t.Fatalf("unexpected dest.Ints: %+v", dest.Ints)
+	}
+}
+
+func TestCopyStruct_NilSliceAndMapPreserved(t *testing.T) {
+	src := collSrc{}
+	var dest collDest
+	if err := CopyStruct(&src, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+	if dest.NilSlice != nil {
+		t.Fatalf("expected nil slice, got %#v", dest.NilSlice)
+	}
+	if dest.NilMap != nil {
+		t.Fatalf("expected nil map, got %#v", dest.NilMap)
+	}
+}
+
+type addr struct {
+	Lines []string
+}
+
+type personSrc struct {
+	Addr addr
+}
+
+type personDest struct {
+	Addr addr
+}
+
+func TestCopyStruct_NestedStructDeepCopiesSliceField(t *testing.T) {
+	src := personSrc{Addr: addr{Lines: []string{"line1"}}}
+	var dest personDest
+	if err := CopyStruct(&src, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+
+	dest.Addr.Lines[0] = "mutated"
+	if src.Addr.Lines[0] == "mutated" {
+		t.Fatal("mutating dest.Addr.Lines mutated src.Addr.Lines: nested struct field was shallow-copied")
+	}
+}
@@ -0,0 +1,60 @@
+package copy_struct
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"unsafe"
+)
+
+type money int64
+
+type moneySrc struct {
+	Amount money
+}
+
+type moneyDest struct {
+	Amount string
+}
+
+func TestRegisterConverter_CustomConversionApplied(t *testing.T) {
+	RegisterConverter(reflect.TypeOf(money(0)), reflect.TypeOf(""), func(src, dest unsafe.Pointer) error {
+		cents := *(*money)(src)
+		*(*string)(dest) = "$" + strconv.FormatInt(int64(cents), 10)
+		return nil
+	})
+
+	src := moneySrc{Amount: 150}
+	var dest moneyDest
+	if err := CopyStruct(&src, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+	if dest.Amount != "$150" {
+		t.Fatalf("expected custom converter output, got %q", dest.Amount)
+	}
+}
+
+func TestRegisterConverter_InvalidatesCache(t *testing.T) {
+	// 使用独立的类型，避免复用上一个测试已注册的 money -> string 转换器
+	type amount int64
+	type srcT struct{ V amount }
+	type destT struct{ V string }
+
+	// 先不注册转换器，走默认规则，建立缓存
+	if err := CopyStruct(&srcT{V: 5}, &destT{}); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+
+	RegisterConverter(reflect.TypeOf(srcT{}.V), reflect.TypeOf(destT{}.V), func(src, dest unsafe.Pointer) error {
+		*(*string)(dest) = "custom"
+		return nil
+	})
+
+	var dest destT
+	if err := CopyStruct(&srcT{V: 5}, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+	if dest.V != "custom" {
+		t.Fatalf("expected newly registered converter to take effect after cache invalidation, got %q", dest.V)
+	}
+}
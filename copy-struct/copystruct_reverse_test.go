@@ -0,0 +1,72 @@
+package copy_struct
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type reverseSrc struct {
+	Age      string
+	Price    string
+	Active   string
+	Empty    string
+	CreateAt string `to:"timeFormat:2006-01-02"`
+}
+
+type reverseDest struct {
+	Age      int32
+	Price    float64
+	Active   bool
+	Empty    int
+	CreateAt time.Time
+}
+
+func TestCopyStruct_StringToNumericBoolTime(t *testing.T) {
+	src := reverseSrc{
+		Age:      "30",
+		Price:    "19.99",
+		Active:   "true",
+		CreateAt: "2024-05-01",
+	}
+	var dest reverseDest
+	if err := CopyStruct(&src, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+	if dest.Age != 30 {
+		t.Errorf("expected Age 30, got %d", dest.Age)
+	}
+	if dest.Price != 19.99 {
+		t.Errorf("expected Price 19.99, got %v", dest.Price)
+	}
+	if !dest.Active {
+		t.Errorf("expected Active true, got %v", dest.Active)
+	}
+	want, _ := time.Parse("2006-01-02", "2024-05-01")
+	if !dest.CreateAt.Equal(want) {
+		t.Errorf("expected CreateAt %v, got %v", want, dest.CreateAt)
+	}
+}
+
+func TestCopyStruct_EmptyStringLeavesZeroValue(t *testing.T) {
+	src := reverseSrc{Empty: ""}
+	var dest reverseDest
+	if err := CopyStruct(&src, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+	if dest.Empty != 0 {
+		t.Fatalf("expected Empty to stay zero value, got %d", dest.Empty)
+	}
+}
+
+func TestCopyStruct_StringToNumeric_ParseErrorReportsFieldName(t *testing.T) {
+	src := reverseSrc{Age: "not-a-number"}
+	var dest reverseDest
+	err := CopyStruct(&src, &dest)
+	if err == nil {
+		t.Fatal("expected parse error, got nil")
+	}
+	if !strings.Contains(err.Error(), `"Age"`) {
+		t.Fatalf("expected parse error to name field Age, got %q", err.Error())
+	}
+}
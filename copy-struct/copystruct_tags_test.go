@@ -0,0 +1,58 @@
+package copy_struct
+
+import "testing"
+
+type tagSrc struct {
+	FullName string `copy:"name=Name"`
+	Secret   string `copy:"-"`
+	Nickname string `copy:"omitempty"`
+	Required string `copy:"required"`
+}
+
+type tagDest struct {
+	Name     string
+	Secret   string
+	Nickname string
+	Required string
+}
+
+func TestCopyStruct_NameTagRenamesField(t *testing.T) {
+	src := tagSrc{FullName: "Alice", Required: "x"}
+	var dest tagDest
+	if err := CopyStruct(&src, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+	if dest.Name != "Alice" {
+		t.Fatalf("expected Name to be mapped from FullName, got %q", dest.Name)
+	}
+}
+
+func TestCopyStruct_SkipTagExcludesField(t *testing.T) {
+	src := tagSrc{Secret: "hidden", Required: "x"}
+	dest := tagDest{Secret: "untouched"}
+	if err := CopyStruct(&src, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+	if dest.Secret != "untouched" {
+		t.Fatalf("expected Secret to be left untouched by copy:\"-\", got %q", dest.Secret)
+	}
+}
+
+func TestCopyStruct_OmitemptySkipsZeroValue(t *testing.T) {
+	src := tagSrc{Required: "x"}
+	dest := tagDest{Nickname: "keep-me"}
+	if err := CopyStruct(&src, &dest); err != nil {
+		t.Fatalf("CopyStruct returned error: %v", err)
+	}
+	if dest.Nickname != "keep-me" {
+		t.Fatalf("expected omitempty to skip zero-value field, got %q", dest.Nickname)
+	}
+}
+
+func TestCopyStruct_RequiredMissingReturnsError(t *testing.T) {
+	src := tagSrc{}
+	var dest tagDest
+	if err := CopyStruct(&src, &dest); err == nil {
+		t.Fatal("expected error for missing required field, got nil")
+	}
+}
@@ -1,28 +1,38 @@
 package validate
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/locales"
 	zhongwen "github.com/go-playground/locales/zh"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 	zh_translations "github.com/go-playground/validator/v10/translations/zh"
-	"reflect"
-	"strings"
 )
 
+// defaultLocaleTag 是包初始化时注册的第一个 locale，未指定/未知 locale 时回退到它
+const defaultLocaleTag = "zh"
+
 var validate *validator.Validate
-var trans ut.Translator
+
+// translatorsMu 保护 translators 和 defaultTag，RegisterLocale 可能在运行时被调用
+var (
+	translatorsMu sync.RWMutex
+	translators   = map[string]ut.Translator{}
+	defaultTag    string
+)
+
+// localeContextKey 是从 context.Context 读写 locale tag 所使用的 key 类型
+type localeContextKey struct{}
 
 // init函数在程序启动时初始化必要的包级变量。
-// 这包括设置中文翻译器和初始化验证器，以便在全局范围内使用。
+// 这包括初始化验证器，并注册中文作为默认 locale。
 func init() {
-	// 创建一个新的中文处理实例。
-	zh := zhongwen.New()
-	// 创建一个通用翻译器实例，使用中文作为唯一语言。
-	uni := ut.New(zh, zh)
-	// 获取一个中文翻译器实例，忽略可能的错误。
-	trans, _ = uni.GetTranslator("zh")
 	// 创建一个新的验证器实例。
 	validate = validator.New()
 	// 注册一个函数来处理结构体字段的标签，以便在验证错误时使用更友好的字段名称。
@@ -33,32 +43,93 @@ func init() {
 		}
 		return label
 	})
-	// 注册默认的翻译消息，以便在验证错误时使用。
-	_ = zh_translations.RegisterDefaultTranslations(validate, trans)
+	// 注册中文 locale 作为默认翻译器。
+	if err := RegisterLocale(defaultLocaleTag, zhongwen.New(), zh_translations.RegisterDefaultTranslations); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterLocale 注册一个 BCP-47 locale 的翻译器。register 用于向 validator 注册
+// 该 locale 的默认翻译文案，例如 zh_translations.RegisterDefaultTranslations。
+// 第一次调用 RegisterLocale 注册的 tag 会成为默认 locale，在 ValidateWithLocale/
+// ValidateContext 请求了未注册的 tag 时作为回退。
+func RegisterLocale(tag string, locale locales.Translator, register func(*validator.Validate, ut.Translator) error) error {
+	uni := ut.New(locale, locale)
+	trans, ok := uni.GetTranslator(tag)
+	if !ok {
+		return fmt.Errorf("validate: translator for locale %q not found", tag)
+	}
+	if err := register(validate, trans); err != nil {
+		return err
+	}
+
+	translatorsMu.Lock()
+	defer translatorsMu.Unlock()
+	if defaultTag == "" {
+		defaultTag = tag
+	}
+	translators[tag] = trans
+	return nil
+}
+
+// resolveTranslator 返回 tag 对应的翻译器，tag 未注册时回退到默认 locale
+func resolveTranslator(tag string) ut.Translator {
+	translatorsMu.RLock()
+	defer translatorsMu.RUnlock()
+	if trans, ok := translators[tag]; ok {
+		return trans
+	}
+	return translators[defaultTag]
 }
 
-// translate函数接收一个错误对象，如果它是验证错误，则将其翻译成中文。
+// translate函数接收一个错误对象，如果它是验证错误，则使用 tag 对应的 locale 翻译。
 // 这个函数返回一个翻译后的错误对象。
-func translate(errs error) error {
+func translate(errs error, tag string) error {
 	// 初始化一个字符串切片来存储翻译后的错误消息。
 	var errList []string
 	// 定义一个变量来存储验证错误。
 	var v validator.ValidationErrors
 	switch {
 	case errors.As(errs, &v):
+		trans := resolveTranslator(tag)
 		for _, e := range v {
 			errList = append(errList, e.Translate(trans))
 		}
 		// 将所有翻译后的错误消息合并成一个字符串并返回。
-		return fmt.Errorf(strings.Join(errList, "|"))
+		return errors.New(strings.Join(errList, "|"))
 	default:
 		return errs
 	}
 }
 
+// Validate 使用默认 locale（包初始化时第一个注册的 locale，通常是中文）校验 r
 func Validate[T any](r T) error {
 	if err := validate.Struct(r); err != nil {
-		return translate(err)
+		return translate(err, defaultTag)
 	}
 	return nil
 }
+
+// ValidateWithLocale 使用指定的 BCP-47 tag 对应的 locale 校验 r；tag 未注册时
+// 回退到默认 locale
+func ValidateWithLocale[T any](r T, tag string) error {
+	if err := validate.Struct(r); err != nil {
+		return translate(err, tag)
+	}
+	return nil
+}
+
+// WithLocale 将 locale tag 写入 context，供 ValidateContext 读取
+func WithLocale(ctx context.Context, tag string) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, tag)
+}
+
+// ValidateContext 是 ValidateWithLocale 的 context.Context 版本，从 ctx 中读取
+// 通过 WithLocale 设置的 locale tag；未设置时使用默认 locale
+func ValidateContext[T any](ctx context.Context, r T) error {
+	tag, _ := ctx.Value(localeContextKey{}).(string)
+	if tag == "" {
+		tag = defaultTag
+	}
+	return ValidateWithLocale(r, tag)
+}
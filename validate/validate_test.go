@@ -0,0 +1,79 @@
+package validate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	en_locale "github.com/go-playground/locales/en"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+)
+
+type demo struct {
+	Name string `validate:"required" label:"姓名"`
+}
+
+func TestValidate_DefaultLocaleIsChinese(t *testing.T) {
+	err := Validate(demo{})
+	if err == nil {
+		t.Fatal("expected validation error, got nil")
+	}
+	if !strings.Contains(err.Error(), "姓名为必填字段") {
+		t.Fatalf("expected translated error to mention 姓名为必填字段, got %q", err.Error())
+	}
+}
+
+func TestValidate_PassesForValidStruct(t *testing.T) {
+	if err := Validate(demo{Name: "Alice"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestRegisterLocale_AndValidateWithLocale(t *testing.T) {
+	if err := RegisterLocale("en", en_locale.New(), en_translations.RegisterDefaultTranslations); err != nil {
+		t.Fatalf("RegisterLocale returned error: %v", err)
+	}
+
+	err := ValidateWithLocale(demo{}, "en")
+	if err == nil {
+		t.Fatal("expected validation error, got nil")
+	}
+	if !strings.Contains(err.Error(), "is a required field") {
+		t.Fatalf("expected English translation, got %q", err.Error())
+	}
+}
+
+func TestValidateWithLocale_UnknownTagFallsBackToDefault(t *testing.T) {
+	err := ValidateWithLocale(demo{}, "fr")
+	if err == nil {
+		t.Fatal("expected validation error, got nil")
+	}
+	if !strings.Contains(err.Error(), "姓名") {
+		t.Fatalf("expected fallback to default locale, got %q", err.Error())
+	}
+}
+
+func TestValidateContext_UsesLocaleFromContext(t *testing.T) {
+	if err := RegisterLocale("en", en_locale.New(), en_translations.RegisterDefaultTranslations); err != nil {
+		t.Fatalf("RegisterLocale returned error: %v", err)
+	}
+
+	ctx := WithLocale(context.Background(), "en")
+	err := ValidateContext(ctx, demo{})
+	if err == nil {
+		t.Fatal("expected validation error, got nil")
+	}
+	if !strings.Contains(err.Error(), "is a required field") {
+		t.Fatalf("expected English translation from context locale, got %q", err.Error())
+	}
+}
+
+func TestValidateContext_NoLocaleUsesDefault(t *testing.T) {
+	err := ValidateContext(context.Background(), demo{})
+	if err == nil {
+		t.Fatal("expected validation error, got nil")
+	}
+	if !strings.Contains(err.Error(), "姓名") {
+		t.Fatalf("expected default locale translation, got %q", err.Error())
+	}
+}